@@ -3,6 +3,7 @@ package iavl
 
 import (
 	"encoding/hex"
+	"errors"
 	mrand "math/rand"
 	"sort"
 	"testing"
@@ -432,6 +433,68 @@ func TestIterateRange(t *testing.T) {
 	expectTraverse(t, trav, "low", "good", 2)
 }
 
+func TestIteratePrefix(t *testing.T) {
+	tree := getTestTree(0)
+	for _, key := range []string{"abc", "fan", "foo", "foobang", "foobar", "foobaz", "food", "foml", "good", "\xff", "\xff\xff"} {
+		_, err := tree.Set([]byte(key), []byte(key))
+		require.NoError(t, err)
+	}
+
+	iteratePrefix := func(prefix string) []string {
+		viewed := []string{}
+		err := tree.IteratePrefix([]byte(prefix), func(key, value []byte) error {
+			require.Equal(t, key, value)
+			viewed = append(viewed, string(key))
+			return nil
+		})
+		require.NoError(t, err)
+		return viewed
+	}
+
+	require.Equal(t, []string{"foo", "foobang", "foobar", "foobaz", "food"}, iteratePrefix("foo"))
+	require.Equal(t, []string{"foobang", "foobar", "foobaz"}, iteratePrefix("fooba"))
+	require.Equal(t, []string{"good"}, iteratePrefix("good"))
+	require.Equal(t, []string{}, iteratePrefix("goods"))
+	require.Equal(t, []string{"\xff", "\xff\xff"}, iteratePrefix("\xff"))
+	require.Len(t, iteratePrefix(""), 11)
+
+	// fn errors stop the iteration and are returned
+	expectErr := errors.New("stop")
+	viewed := 0
+	err := tree.IteratePrefix([]byte("foo"), func(key, value []byte) error {
+		viewed++
+		if string(key) == "foobar" {
+			return expectErr
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, expectErr)
+	require.Equal(t, 3, viewed)
+
+	// empty tree
+	err = getTestTree(0).IteratePrefix([]byte("foo"), func(key, value []byte) error {
+		return expectErr
+	})
+	require.NoError(t, err)
+
+	// errors loading nodes are returned rather than ending the iteration early
+	_, version, err := tree.SaveVersion()
+	require.NoError(t, err)
+	itree, err := tree.GetImmutable(version)
+	require.NoError(t, err)
+	batch := tree.ndb.db.NewBatch()
+	require.NoError(t, batch.Delete(tree.ndb.nodeKey(itree.root.rightNodeKey)))
+	require.NoError(t, batch.Write())
+	require.NoError(t, batch.Close())
+	viewed = 0
+	err = itree.IteratePrefix(nil, func(key, value []byte) error {
+		viewed++
+		return nil
+	})
+	require.Error(t, err)
+	require.Less(t, viewed, 11)
+}
+
 func TestPersistence(t *testing.T) {
 	db := dbm.NewMemDB()
 
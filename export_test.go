@@ -228,8 +228,10 @@ func TestExporterCompress(t *testing.T) {
 
 func TestExporter_Import(t *testing.T) {
 	testcases := map[string]*ImmutableTree{
-		"empty tree": NewImmutableTree(dbm.NewMemDB(), 0, false, log.NewNopLogger()),
-		"basic tree": setupExportTreeBasic(t),
+		"empty tree":       NewImmutableTree(dbm.NewMemDB(), 0, false, log.NewNopLogger()),
+		"single leaf tree": setupExportTreeSized(t, 1),
+		"two leaf tree":    setupExportTreeSized(t, 2),
+		"basic tree":       setupExportTreeBasic(t),
 	}
 	if !testing.Short() {
 		testcases["sized tree"] = setupExportTreeSized(t, 4096)
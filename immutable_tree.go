@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/log"
 
 	dbm "github.com/cosmos/iavl/db"
+	ibytes "github.com/cosmos/iavl/internal/bytes"
 )
 
 // ImmutableTree contains the immutable tree at a given version. It is typically created by calling
@@ -290,6 +291,36 @@ func (t *ImmutableTree) IterateRangeInclusive(start, end []byte, ascending bool,
 	})
 }
 
+// IteratePrefix makes a callback for all nodes with key starting with prefix, in ascending order.
+// An empty prefix iterates the whole tree. Iteration stops at the first error returned by fn or
+// encountered while loading nodes, which is returned. The keys and values must not be modified, since they may point to data
+// stored within IAVL.
+func (t *ImmutableTree) IteratePrefix(prefix []byte, fn func(key, value []byte) error) error {
+	if t.root == nil {
+		return nil
+	}
+	var start, end []byte
+	if len(prefix) > 0 {
+		start = prefix
+		end = ibytes.CpIncr(prefix)
+	}
+	traversal := t.root.newTraversal(t, start, end, true, false, false)
+	for {
+		node, err := traversal.next()
+		if err != nil {
+			return err
+		}
+		if node == nil {
+			return nil
+		}
+		if node.subtreeHeight == 0 {
+			if err := fn(node.key, node.value); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // IsFastCacheEnabled returns true if fast cache is enabled, false otherwise.
 // For fast cache to be enabled, the following 2 conditions must be met:
 // 1. The tree is of the latest version.
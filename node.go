@@ -86,28 +86,45 @@ func (node *Node) GetKey() []byte {
 	return node.nodeKey.GetKey()
 }
 
-// MakeNode constructs an *Node from an encoded byte slice.
+// MakeNode constructs an *Node from an encoded byte slice. The encoding must be consumed
+// exactly; decoding errors and trailing bytes are reported with the byte offset at which
+// decoding stopped and a hex dump of the surrounding bytes.
 func MakeNode(nk, buf []byte) (*Node, error) {
+	node, rest, err := decodeNode(nk, buf)
+	offset := len(buf) - len(rest)
+	if err != nil {
+		return nil, fmt.Errorf("%w (offset %d, bytes %s)", err, offset, hexAround(buf, offset))
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("decoding node, %d trailing bytes (offset %d, bytes %s)",
+			len(rest), offset, hexAround(buf, offset))
+	}
+	return node, nil
+}
+
+// decodeNode decodes a node from buf, returning the unread remainder of buf. On error, the
+// remainder starts at the field that failed to decode.
+func decodeNode(nk, buf []byte) (*Node, []byte, error) {
 	// Read node header (height, size, key).
 	height, n, err := encoding.DecodeVarint(buf)
 	if err != nil {
-		return nil, fmt.Errorf("decoding node.height, %w", err)
+		return nil, buf, fmt.Errorf("decoding node.height, %w", err)
 	}
-	buf = buf[n:]
 	height8 := int8(height)
 	if height != int64(height8) {
-		return nil, errors.New("invalid height, out of int8 range")
+		return nil, buf, errors.New("invalid height, out of int8 range")
 	}
+	buf = buf[n:]
 
 	size, n, err := encoding.DecodeVarint(buf)
 	if err != nil {
-		return nil, fmt.Errorf("decoding node.size, %w", err)
+		return nil, buf, fmt.Errorf("decoding node.size, %w", err)
 	}
 	buf = buf[n:]
 
 	key, n, err := encoding.DecodeBytes(buf)
 	if err != nil {
-		return nil, fmt.Errorf("decoding node.key, %w", err)
+		return nil, buf, fmt.Errorf("decoding node.key, %w", err)
 	}
 	buf = buf[n:]
 
@@ -120,33 +137,34 @@ func MakeNode(nk, buf []byte) (*Node, error) {
 
 	// Read node body.
 	if node.isLeaf() {
-		val, _, err := encoding.DecodeBytes(buf)
+		val, n, err := encoding.DecodeBytes(buf)
 		if err != nil {
-			return nil, fmt.Errorf("decoding node.value, %w", err)
+			return nil, buf, fmt.Errorf("decoding node.value, %w", err)
 		}
+		buf = buf[n:]
 		node.value = val
 		// ensure take the hash for the leaf node
 		node._hash(node.nodeKey.version)
 	} else { // Read children.
 		node.hash, n, err = encoding.DecodeBytes(buf)
 		if err != nil {
-			return nil, fmt.Errorf("decoding node.hash, %w", err)
+			return nil, buf, fmt.Errorf("decoding node.hash, %w", err)
 		}
 		buf = buf[n:]
 
 		mode, n, err := encoding.DecodeVarint(buf)
 		if err != nil {
-			return nil, fmt.Errorf("decoding mode, %w", err)
+			return nil, buf, fmt.Errorf("decoding mode, %w", err)
 		}
-		buf = buf[n:]
 		if mode < 0 || mode > 3 {
-			return nil, errors.New("invalid mode")
+			return nil, buf, errors.New("invalid mode")
 		}
+		buf = buf[n:]
 
 		if mode&ModeLegacyLeftNode != 0 { // legacy leftNodeKey
 			node.leftNodeKey, n, err = encoding.DecodeBytes(buf)
 			if err != nil {
-				return nil, fmt.Errorf("decoding legacy node.leftNodeKey, %w", err)
+				return nil, buf, fmt.Errorf("decoding legacy node.leftNodeKey, %w", err)
 			}
 			buf = buf[n:]
 		} else {
@@ -156,25 +174,26 @@ func MakeNode(nk, buf []byte) (*Node, error) {
 			)
 			leftNodeKey.version, n, err = encoding.DecodeVarint(buf)
 			if err != nil {
-				return nil, fmt.Errorf("decoding node.leftNodeKey.version, %w", err)
+				return nil, buf, fmt.Errorf("decoding node.leftNodeKey.version, %w", err)
 			}
 			buf = buf[n:]
 			nonce, n, err = encoding.DecodeVarint(buf)
 			if err != nil {
-				return nil, fmt.Errorf("decoding node.leftNodeKey.nonce, %w", err)
+				return nil, buf, fmt.Errorf("decoding node.leftNodeKey.nonce, %w", err)
 			}
-			buf = buf[n:]
 			leftNodeKey.nonce = uint32(nonce)
 			if nonce != int64(leftNodeKey.nonce) {
-				return nil, errors.New("invalid leftNodeKey.nonce, out of int32 range")
+				return nil, buf, errors.New("invalid leftNodeKey.nonce, out of int32 range")
 			}
+			buf = buf[n:]
 			node.leftNodeKey = leftNodeKey.GetKey()
 		}
 		if mode&ModeLegacyRightNode != 0 { // legacy rightNodeKey
-			node.rightNodeKey, _, err = encoding.DecodeBytes(buf)
+			node.rightNodeKey, n, err = encoding.DecodeBytes(buf)
 			if err != nil {
-				return nil, fmt.Errorf("decoding legacy node.rightNodeKey, %w", err)
+				return nil, buf, fmt.Errorf("decoding legacy node.rightNodeKey, %w", err)
 			}
+			buf = buf[n:]
 		} else {
 			var (
 				rightNodeKey NodeKey
@@ -182,21 +201,40 @@ func MakeNode(nk, buf []byte) (*Node, error) {
 			)
 			rightNodeKey.version, n, err = encoding.DecodeVarint(buf)
 			if err != nil {
-				return nil, fmt.Errorf("decoding node.rightNodeKey.version, %w", err)
+				return nil, buf, fmt.Errorf("decoding node.rightNodeKey.version, %w", err)
 			}
 			buf = buf[n:]
-			nonce, _, err = encoding.DecodeVarint(buf)
+			nonce, n, err = encoding.DecodeVarint(buf)
 			if err != nil {
-				return nil, fmt.Errorf("decoding node.rightNodeKey.nonce, %w", err)
+				return nil, buf, fmt.Errorf("decoding node.rightNodeKey.nonce, %w", err)
 			}
 			rightNodeKey.nonce = uint32(nonce)
 			if nonce != int64(rightNodeKey.nonce) {
-				return nil, errors.New("invalid rightNodeKey.nonce, out of int32 range")
+				return nil, buf, errors.New("invalid rightNodeKey.nonce, out of int32 range")
 			}
+			buf = buf[n:]
 			node.rightNodeKey = rightNodeKey.GetKey()
 		}
 	}
-	return node, nil
+	return node, buf, nil
+}
+
+// hexAround returns a hex dump of up to 16 bytes on either side of offset in bz, with the
+// byte at offset enclosed in brackets.
+func hexAround(bz []byte, offset int) string {
+	const radius = 16
+	start := offset - radius
+	if start < 0 {
+		start = 0
+	}
+	if offset >= len(bz) {
+		return fmt.Sprintf("%x[]", bz[start:])
+	}
+	end := offset + radius
+	if end > len(bz) {
+		end = len(bz)
+	}
+	return fmt.Sprintf("%x[%02x]%x", bz[start:offset], bz[offset], bz[offset+1:end])
 }
 
 // MakeLegacyNode constructs a legacy *Node from an encoded byte slice.
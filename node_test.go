@@ -113,6 +113,82 @@ func TestNode_encode_decode(t *testing.T) {
 	}
 }
 
+func TestMakeNode_decodeErrors(t *testing.T) {
+	nk := (&NodeKey{version: 3, nonce: 1}).GetKey()
+	leaf, err := hex.DecodeString("0002036b65790576616c7565")
+	require.NoError(t, err)
+	// height 200 does not fit in an int8
+	badHeight, err := hex.DecodeString("900302036b65790576616c7565")
+	require.NoError(t, err)
+	// inner node with mode 4
+	badMode, err := hex.DecodeString("060e036b657904708090a00802020202")
+	require.NoError(t, err)
+
+	testcases := map[string]struct {
+		buf       []byte
+		expectErr string
+	}{
+		"valid":          {leaf, ""},
+		"trailing bytes": {append(append([]byte{}, leaf...), 0xff, 0xee), "2 trailing bytes (offset 12, bytes 0002036b65790576616c7565[ff]ee)"},
+		"truncated":      {leaf[:8], "decoding node.value, insufficient bytes decoding []byte of length 5 (offset 6, bytes 0002036b6579[05]76)"},
+		"truncated size": {leaf[:1], "decoding node.size"},
+		"bad height":     {badHeight, "invalid height, out of int8 range (offset 0, bytes [90]0302036b65790576616c7565)"},
+		"bad mode":       {badMode, "invalid mode (offset 11, bytes 060e036b657904708090a0[08]02020202)"},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			_, err := MakeNode(nk, tc.buf)
+			if tc.expectErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expectErr)
+		})
+	}
+}
+
+func TestMakeNode_noAliasing(t *testing.T) {
+	// Callers may reuse the buffer passed to MakeNode (e.g. DB iterators), so the decoded node
+	// must not retain references into it.
+	leaf := &Node{
+		key:     []byte("key"),
+		value:   []byte("value"),
+		size:    1,
+		nodeKey: &NodeKey{version: 3, nonce: 1},
+	}
+	inner := &Node{
+		key:           []byte("key"),
+		size:          2,
+		subtreeHeight: 1,
+		nodeKey:       &NodeKey{version: 3, nonce: 2},
+		leftNodeKey:   (&NodeKey{version: 1, nonce: 1}).GetKey(),
+		rightNodeKey:  iavlrand.RandBytes(hashSize),
+		hash:          iavlrand.RandBytes(hashSize),
+	}
+
+	for _, expect := range []*Node{leaf, inner} {
+		var buf bytes.Buffer
+		require.NoError(t, expect.writeBytes(&buf))
+		bz := buf.Bytes()
+
+		node, err := MakeNode(expect.GetKey(), bz)
+		require.NoError(t, err)
+		for i := range bz {
+			bz[i] = 0xff
+		}
+
+		require.Equal(t, expect.key, node.key)
+		require.Equal(t, expect.value, node.value)
+		if !expect.isLeaf() {
+			require.Equal(t, expect.hash, node.hash)
+			require.Equal(t, expect.leftNodeKey, node.leftNodeKey)
+			require.Equal(t, expect.rightNodeKey, node.rightNodeKey)
+		}
+	}
+}
+
 func TestNode_validate(t *testing.T) {
 	k := []byte("key")
 	v := []byte("value")
@@ -153,7 +153,7 @@ func (ndb *nodeDB) GetNode(nk []byte) (*Node, error) {
 	} else {
 		node, err = MakeNode(nk, buf)
 		if err != nil {
-			return nil, fmt.Errorf("error reading Node. bytes: %x, error: %v", buf, err)
+			return nil, fmt.Errorf("error reading Node. nodeKey: %x, error: %v", nk, err)
 		}
 	}
 